@@ -0,0 +1,166 @@
+// Package ctx implements a context-aware variant of the Result type.
+package ctx
+
+import (
+	"context"
+	"time"
+
+	"github.com/onur1/data"
+)
+
+// CtxResult is like data.Result but threads a context.Context through
+// evaluation, allowing callers to cancel or time out an in-flight
+// computation.
+type CtxResult[A any] func(context.Context) (A, error)
+
+// Ok creates a context-aware result which never fails and returns a value
+// of type A.
+func Ok[A any](a A) CtxResult[A] {
+	return func(context.Context) (A, error) {
+		return a, nil
+	}
+}
+
+// Error creates a context-aware result which always fails with an error.
+func Error[A any](err error) CtxResult[A] {
+	return func(context.Context) (a A, _ error) {
+		return a, err
+	}
+}
+
+// Lift turns a data.Result[A] into a CtxResult[A], checking ctx.Err()
+// before and after evaluating the underlying result.
+func Lift[A any](ma data.Result[A]) CtxResult[A] {
+	return func(ctx context.Context) (a A, err error) {
+		if err = ctx.Err(); err != nil {
+			return a, err
+		}
+		if a, err = ma(); err != nil {
+			return a, err
+		}
+		return a, ctx.Err()
+	}
+}
+
+// Map creates a context-aware result by applying a function on a succeeding
+// result.
+func Map[A, B any](fa CtxResult[A], f func(A) B) CtxResult[B] {
+	return func(c context.Context) (b B, _ error) {
+		a, err := fa(c)
+		if err != nil {
+			return b, err
+		}
+		return f(a), nil
+	}
+}
+
+// MapError creates a context-aware result by applying a function on a
+// failing result.
+func MapError[A any](fa CtxResult[A], f func(error) error) CtxResult[A] {
+	return func(c context.Context) (A, error) {
+		a, err := fa(c)
+		if err != nil {
+			return a, f(err)
+		}
+		return a, nil
+	}
+}
+
+// Ap creates a context-aware result by applying a function contained in the
+// first result on the value contained in the second result.
+func Ap[A, B any](fab CtxResult[func(A) B], fa CtxResult[A]) CtxResult[B] {
+	return func(c context.Context) (b B, _ error) {
+		ab, err := fab(c)
+		if err != nil {
+			return b, err
+		}
+		a, err := fa(c)
+		if err != nil {
+			return b, err
+		}
+		return ab(a), nil
+	}
+}
+
+// Chain creates a context-aware result which combines two results in
+// sequence, using the return value of one result to determine the next
+// one. Evaluation short-circuits if ctx is cancelled between steps.
+func Chain[A, B any](ma CtxResult[A], f func(A) CtxResult[B]) CtxResult[B] {
+	return func(c context.Context) (b B, _ error) {
+		a, err := ma(c)
+		if err != nil {
+			return b, err
+		}
+		if err = c.Err(); err != nil {
+			return b, err
+		}
+		return f(a)(c)
+	}
+}
+
+// Bimap creates a context-aware result by mapping a pair of functions over
+// an error or a value contained in a result.
+func Bimap[A, B any](fa CtxResult[A], f func(error) error, g func(A) B) CtxResult[B] {
+	return func(c context.Context) (b B, _ error) {
+		a, err := fa(c)
+		if err != nil {
+			return b, f(err)
+		}
+		return g(a), nil
+	}
+}
+
+// Fold takes two functions and a context-aware result and returns a value
+// by applying one of the supplied functions to the inner value.
+func Fold[A, B any](ma CtxResult[A], c context.Context, onError func(error) B, onSuccess func(A) B) B {
+	a, err := ma(c)
+	if err != nil {
+		return onError(err)
+	}
+	return onSuccess(a)
+}
+
+// OrElse creates a context-aware result which can be used to recover from
+// a failing result by switching to a new result.
+func OrElse[A any](ma CtxResult[A], onError func(error) CtxResult[A]) CtxResult[A] {
+	return func(c context.Context) (A, error) {
+		a, err := ma(c)
+		if err != nil {
+			return onError(err)(c)
+		}
+		return a, nil
+	}
+}
+
+// FilterOrElse creates a context-aware result which can be used to fail
+// with an error unless a predicate holds on a succeeding result.
+func FilterOrElse[A any](ma CtxResult[A], predicate data.Predicate[A], onFalse func(A) error) CtxResult[A] {
+	return Chain(ma, func(a A) CtxResult[A] {
+		if predicate(a) {
+			return Ok(a)
+		}
+		return Error[A](onFalse(a))
+	})
+}
+
+// WithTimeout creates a context-aware result which evaluates r with a
+// context that expires after d. The outcome of r is returned as is; r fails
+// with context.DeadlineExceeded only if it observes the expired context.
+func WithTimeout[A any](d time.Duration, r CtxResult[A]) CtxResult[A] {
+	return func(c context.Context) (A, error) {
+		c, cancel := context.WithTimeout(c, d)
+		defer cancel()
+		return r(c)
+	}
+}
+
+// WithDeadline creates a context-aware result which evaluates r with a
+// context that expires at d. The outcome of r is returned as is; r fails
+// with context.DeadlineExceeded only if it observes the expired context.
+func WithDeadline[A any](d time.Time, r CtxResult[A]) CtxResult[A] {
+	return func(c context.Context) (A, error) {
+		c, cancel := context.WithDeadline(c, d)
+		defer cancel()
+		return r(c)
+	}
+}
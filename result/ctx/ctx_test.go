@@ -0,0 +1,62 @@
+package ctx_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onur1/data/result"
+	"github.com/onur1/data/result/ctx"
+	"github.com/stretchr/testify/assert"
+)
+
+var errFailed = errors.New("failed")
+
+func TestChainCancellation(t *testing.T) {
+	c, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var called bool
+
+	_, err := ctx.Chain(ctx.Ok(1), func(n int) ctx.CtxResult[int] {
+		called = true
+		return ctx.Ok(n + 1)
+	})(c)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called, "Chain must not evaluate subsequent stages after cancellation")
+}
+
+func TestWithTimeoutExpires(t *testing.T) {
+	slow := func(c context.Context) (int, error) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return 42, nil
+		case <-c.Done():
+			return 0, c.Err()
+		}
+	}
+
+	_, err := ctx.WithTimeout(time.Millisecond, ctx.CtxResult[int](slow))(context.Background())
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLift(t *testing.T) {
+	c, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ctx.Lift(result.Ok(42))(c)
+
+	assert.ErrorIs(t, err, context.Canceled)
+
+	v, err := ctx.Lift(result.Ok(42))(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 42, v)
+
+	_, err = ctx.Lift(result.Error[int](errFailed))(context.Background())
+
+	assert.ErrorIs(t, err, errFailed)
+}
@@ -0,0 +1,50 @@
+package ctx
+
+import (
+	"context"
+	"time"
+
+	"github.com/onur1/data/result"
+)
+
+// Retry is a context-aware version of result.Retry: it re-invokes ma up to
+// policy.MaxAttempts times, sleeping between attempts according to policy,
+// but aborts immediately with ctx.Err() if ctx is cancelled while sleeping
+// or before the next attempt.
+func Retry[A any](ma CtxResult[A], policy result.RetryPolicy) CtxResult[A] {
+	return func(c context.Context) (a A, err error) {
+		delay := policy.InitialDelay
+
+		for attempt := 1; ; attempt++ {
+			if err = c.Err(); err != nil {
+				return a, err
+			}
+
+			a, err = ma(c)
+			if err == nil {
+				return a, nil
+			}
+
+			if attempt >= policy.MaxAttempts {
+				return a, err
+			}
+
+			if policy.ShouldRetry != nil && !policy.ShouldRetry(err) {
+				return a, err
+			}
+
+			timer := time.NewTimer(result.WithJitter(delay, policy.Jitter))
+			select {
+			case <-c.Done():
+				timer.Stop()
+				return a, c.Err()
+			case <-timer.C:
+			}
+
+			delay = time.Duration(float64(delay) * policy.Multiplier)
+			if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+				delay = policy.MaxDelay
+			}
+		}
+	}
+}
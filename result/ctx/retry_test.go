@@ -0,0 +1,53 @@
+package ctx_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onur1/data/result"
+	"github.com/onur1/data/result/ctx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCtxRetryAbortsOnCancel(t *testing.T) {
+	c, cancel := context.WithCancel(context.Background())
+
+	var attempts int
+
+	go func() {
+		time.Sleep(5 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := ctx.Retry(func(context.Context) (int, error) {
+		attempts++
+		return 0, errFailed
+	}, result.RetryPolicy{
+		MaxAttempts:  100,
+		InitialDelay: time.Second,
+		Multiplier:   1,
+	})(c)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, attempts, 100)
+}
+
+func TestCtxRetrySucceeds(t *testing.T) {
+	var attempts int
+
+	v, err := ctx.Retry(func(context.Context) (int, error) {
+		attempts++
+		if attempts < 2 {
+			return 0, errFailed
+		}
+		return 7, nil
+	}, result.RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Millisecond,
+		Multiplier:   1,
+	})(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 7, v)
+}
@@ -0,0 +1,104 @@
+package result
+
+import "github.com/onur1/data"
+
+// Builder accumulates a sequence of steps over a value of type S, short-
+// circuiting on the first failing Bind. Construct one with Do.
+type Builder[S any] struct {
+	ma data.Result[S]
+}
+
+// Do starts a builder seeded with initial, to be composed via Bind and Let
+// and finished with Return. Each step is evaluated as soon as it is added.
+func Do[S any](initial S) Builder[S] {
+	return Builder[S]{ma: Ok(initial)}
+}
+
+// Bind chains a step which may fail, threading the accumulated state S
+// through f and replacing it with f's result. Bind is skipped once a prior
+// step has failed.
+func (b Builder[S]) Bind(f func(S) data.Result[S]) Builder[S] {
+	return Builder[S]{ma: Chain(b.ma, f)}
+}
+
+// Let applies a pure transformation to the accumulated state S. Let is
+// skipped once a prior step has failed.
+func (b Builder[S]) Let(f func(S) S) Builder[S] {
+	return Builder[S]{ma: Map(b.ma, f)}
+}
+
+// Return finishes the builder, yielding the accumulated state as a
+// data.Result[S].
+func (b Builder[S]) Return() data.Result[S] {
+	return b.ma
+}
+
+// Pair holds two independently-produced values, as returned by Zip2.
+type Pair[A, B any] struct {
+	Fst A
+	Snd B
+}
+
+// Zip2 combines two independent results into a data.Result[Pair[A, B]],
+// failing with the first error encountered.
+func Zip2[A, B any](fa data.Result[A], fb data.Result[B]) data.Result[Pair[A, B]] {
+	return Ap(Map(fa, func(a A) func(B) Pair[A, B] {
+		return func(b B) Pair[A, B] {
+			return Pair[A, B]{Fst: a, Snd: b}
+		}
+	}), fb)
+}
+
+// Triple holds three independently-produced values, as returned by Zip3.
+type Triple[A, B, C any] struct {
+	Fst A
+	Snd B
+	Thd C
+}
+
+// Zip3 combines three independent results into a data.Result[Triple[A, B, C]],
+// failing with the first error encountered.
+func Zip3[A, B, C any](fa data.Result[A], fb data.Result[B], fc data.Result[C]) data.Result[Triple[A, B, C]] {
+	return Ap(Map(Zip2(fa, fb), func(ab Pair[A, B]) func(C) Triple[A, B, C] {
+		return func(c C) Triple[A, B, C] {
+			return Triple[A, B, C]{Fst: ab.Fst, Snd: ab.Snd, Thd: c}
+		}
+	}), fc)
+}
+
+// Quad holds four independently-produced values, as returned by Zip4.
+type Quad[A, B, C, D any] struct {
+	Fst A
+	Snd B
+	Thd C
+	Fth D
+}
+
+// Zip4 combines four independent results into a data.Result[Quad[A, B, C, D]],
+// failing with the first error encountered.
+func Zip4[A, B, C, D any](fa data.Result[A], fb data.Result[B], fc data.Result[C], fd data.Result[D]) data.Result[Quad[A, B, C, D]] {
+	return Ap(Map(Zip3(fa, fb, fc), func(abc Triple[A, B, C]) func(D) Quad[A, B, C, D] {
+		return func(d D) Quad[A, B, C, D] {
+			return Quad[A, B, C, D]{Fst: abc.Fst, Snd: abc.Snd, Thd: abc.Thd, Fth: d}
+		}
+	}), fd)
+}
+
+// Quint holds five independently-produced values, as returned by Zip5.
+type Quint[A, B, C, D, E any] struct {
+	Fst A
+	Snd B
+	Thd C
+	Fth D
+	Fft E
+}
+
+// Zip5 combines five independent results into a data.Result[Quint[A, B, C, D, E]],
+// failing with the first error encountered.
+func Zip5[A, B, C, D, E any](fa data.Result[A], fb data.Result[B], fc data.Result[C], fd data.Result[D], fe data.Result[E]) data.Result[Quint[A, B, C, D, E]] {
+	return Ap(Map(Zip4(fa, fb, fc, fd), func(abcd Quad[A, B, C, D]) func(E) Quint[A, B, C, D, E] {
+		return func(e E) Quint[A, B, C, D, E] {
+			return Quint[A, B, C, D, E]{Fst: abcd.Fst, Snd: abcd.Snd, Thd: abcd.Thd, Fth: abcd.Fth, Fft: e}
+		}
+	}), fe)
+}
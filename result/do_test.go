@@ -0,0 +1,78 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/onur1/data"
+	"github.com/onur1/data/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoReturnsAccumulatedState(t *testing.T) {
+	type state struct {
+		a, b int
+	}
+
+	v, err := result.Do(state{}).
+		Bind(func(s state) data.Result[state] {
+			s.a = 1
+			return result.Ok(s)
+		}).
+		Let(func(s state) state {
+			s.b = s.a + 1
+			return s
+		}).
+		Return()()
+
+	assert.NoError(t, err)
+	assert.Equal(t, state{a: 1, b: 2}, v)
+}
+
+func TestDoShortCircuits(t *testing.T) {
+	var laterCalled bool
+
+	_, err := result.Do(0).
+		Bind(func(int) data.Result[int] {
+			return result.Error[int](errFailed)
+		}).
+		Let(func(n int) int {
+			laterCalled = true
+			return n + 1
+		}).
+		Return()()
+
+	assert.ErrorIs(t, err, errFailed)
+	assert.False(t, laterCalled, "steps after a failing Bind must not run")
+}
+
+func TestZip2(t *testing.T) {
+	p, err := result.Zip2(result.Ok(1), result.Ok("a"))()
+
+	assert.NoError(t, err)
+	assert.Equal(t, result.Pair[int, string]{Fst: 1, Snd: "a"}, p)
+}
+
+func TestZip3FailsFast(t *testing.T) {
+	_, err := result.Zip3(
+		result.Ok(1),
+		result.Error[string](errFailed),
+		result.Ok(true),
+	)()
+
+	assert.ErrorIs(t, err, errFailed)
+}
+
+func TestZip5(t *testing.T) {
+	q, err := result.Zip5(
+		result.Ok(1),
+		result.Ok("a"),
+		result.Ok(true),
+		result.Ok(1.5),
+		result.Ok[byte]('x'),
+	)()
+
+	assert.NoError(t, err)
+	assert.Equal(t, result.Quint[int, string, bool, float64, byte]{
+		Fst: 1, Snd: "a", Thd: true, Fth: 1.5, Fft: 'x',
+	}, q)
+}
@@ -0,0 +1,58 @@
+package result
+
+import (
+	"errors"
+
+	"github.com/onur1/data"
+)
+
+// RecoverIs creates a result which recovers from a failing result by
+// switching to the result returned by onMatch, but only when the error
+// matches target per errors.Is. Non-matching errors pass through unchanged.
+func RecoverIs[A any](ma data.Result[A], target error, onMatch func(error) data.Result[A]) data.Result[A] {
+	return OrElse(ma, func(err error) data.Result[A] {
+		if errors.Is(err, target) {
+			return onMatch(err)
+		}
+		return Error[A](err)
+	})
+}
+
+// RecoverAs creates a result which recovers from a failing result by
+// switching to the result returned by onMatch, but only when the error can
+// be unwrapped into E per errors.As. Non-matching errors pass through
+// unchanged.
+func RecoverAs[A any, E error](ma data.Result[A], onMatch func(E) data.Result[A]) data.Result[A] {
+	return OrElse(ma, func(err error) data.Result[A] {
+		var target E
+		if errors.As(err, &target) {
+			return onMatch(target)
+		}
+		return Error[A](err)
+	})
+}
+
+// MapErrorIs creates a result which applies f to a failing result's error,
+// but only when the error matches target per errors.Is. Non-matching
+// errors pass through unchanged.
+func MapErrorIs[A any](ma data.Result[A], target error, f func(error) error) data.Result[A] {
+	return MapError(ma, func(err error) error {
+		if errors.Is(err, target) {
+			return f(err)
+		}
+		return err
+	})
+}
+
+// MapErrorAs creates a result which applies f to a failing result's error,
+// but only when the error can be unwrapped into E per errors.As.
+// Non-matching errors pass through unchanged.
+func MapErrorAs[A any, E error](ma data.Result[A], f func(E) error) data.Result[A] {
+	return MapError(ma, func(err error) error {
+		var target E
+		if errors.As(err, &target) {
+			return f(target)
+		}
+		return err
+	})
+}
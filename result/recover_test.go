@@ -0,0 +1,90 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/onur1/data"
+	"github.com/onur1/data/result"
+	"github.com/stretchr/testify/assert"
+)
+
+type notFoundError struct {
+	name string
+}
+
+func (e *notFoundError) Error() string {
+	return fmt.Sprintf("%s: not found", e.name)
+}
+
+func TestRecoverIsMatch(t *testing.T) {
+	wrapped := fmt.Errorf("delete: %w", errNotFound)
+
+	v, err := result.RecoverIs(
+		result.Error[int](wrapped),
+		errNotFound,
+		func(error) data.Result[int] {
+			return result.Ok(0)
+		},
+	)()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 0, v)
+}
+
+func TestRecoverIsNoMatch(t *testing.T) {
+	_, err := result.RecoverIs(
+		result.Error[int](errFailed),
+		errNotFound,
+		func(error) data.Result[int] {
+			return result.Ok(0)
+		},
+	)()
+
+	assert.ErrorIs(t, err, errFailed)
+}
+
+func TestRecoverAsMatch(t *testing.T) {
+	wrapped := fmt.Errorf("delete: %w", &notFoundError{name: "foo"})
+
+	v, err := result.RecoverAs(
+		result.Error[int](wrapped),
+		func(e *notFoundError) data.Result[int] {
+			return result.Ok(len(e.name))
+		},
+	)()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+}
+
+func TestRecoverAsNoMatch(t *testing.T) {
+	_, err := result.RecoverAs(
+		result.Error[int](errFailed),
+		func(e *notFoundError) data.Result[int] {
+			return result.Ok(0)
+		},
+	)()
+
+	assert.ErrorIs(t, err, errFailed)
+}
+
+func TestMapErrorIs(t *testing.T) {
+	wrapped := fmt.Errorf("delete: %w", errNotFound)
+
+	_, err := result.MapErrorIs(result.Error[int](wrapped), errNotFound, wrappedError)()
+
+	assert.True(t, errors.Is(err, errNotFound))
+	assert.True(t, errors.Is(err, errFailed) == false)
+}
+
+func TestMapErrorAs(t *testing.T) {
+	wrapped := fmt.Errorf("delete: %w", &notFoundError{name: "foo"})
+
+	_, err := result.MapErrorAs(result.Error[int](wrapped), func(e *notFoundError) error {
+		return fmt.Errorf("recovered: %w", e)
+	})()
+
+	assert.ErrorContains(t, err, "recovered")
+}
@@ -0,0 +1,90 @@
+package result
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/onur1/data"
+)
+
+// RetryPolicy configures how Retry re-invokes a failing result.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialDelay is the delay before the second attempt.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts.
+	MaxDelay time.Duration
+	// Multiplier scales the delay after each attempt (exponential backoff).
+	Multiplier float64
+	// Jitter is a fraction of the computed delay (0 to 1) added or
+	// subtracted at random to avoid thundering herds.
+	Jitter float64
+	// ShouldRetry classifies whether an error is retryable. A nil
+	// ShouldRetry retries every error.
+	ShouldRetry func(error) bool
+}
+
+// Retry creates a result which re-invokes ma up to policy.MaxAttempts
+// times, sleeping between attempts according to policy, until it succeeds
+// or policy.ShouldRetry rejects the error. If attempts are exhausted the
+// last observed error is returned.
+func Retry[A any](ma data.Result[A], policy RetryPolicy) data.Result[A] {
+	return func() (a A, err error) {
+		delay := policy.InitialDelay
+
+		for attempt := 1; ; attempt++ {
+			a, err = ma()
+			if err == nil {
+				return a, nil
+			}
+
+			if attempt >= policy.MaxAttempts {
+				return a, err
+			}
+
+			if policy.ShouldRetry != nil && !policy.ShouldRetry(err) {
+				return a, err
+			}
+
+			time.Sleep(WithJitter(delay, policy.Jitter))
+
+			delay = time.Duration(float64(delay) * policy.Multiplier)
+			if policy.MaxDelay > 0 && delay > policy.MaxDelay {
+				delay = policy.MaxDelay
+			}
+		}
+	}
+}
+
+// RetryN creates a result which evaluates ma at most n times in total using
+// a fixed delay of d between attempts.
+func RetryN[A any](ma data.Result[A], n int, d time.Duration) data.Result[A] {
+	return Retry(ma, RetryPolicy{
+		MaxAttempts:  n,
+		InitialDelay: d,
+		Multiplier:   1,
+	})
+}
+
+// RetryWhile creates a result which evaluates ma at most n times in total
+// using a fixed delay of d between attempts, stopping early when pred returns false for
+// an observed error.
+func RetryWhile[A any](ma data.Result[A], n int, d time.Duration, pred func(error) bool) data.Result[A] {
+	return Retry(ma, RetryPolicy{
+		MaxAttempts:  n,
+		InitialDelay: d,
+		Multiplier:   1,
+		ShouldRetry:  pred,
+	})
+}
+
+// WithJitter adds or subtracts a random fraction (0 to 1) of d, as
+// configured by jitter, to avoid thundering herds of synchronized retries.
+func WithJitter(d time.Duration, jitter float64) time.Duration {
+	if jitter <= 0 {
+		return d
+	}
+	spread := float64(d) * jitter
+	return d + time.Duration(spread*(2*rand.Float64()-1))
+}
@@ -0,0 +1,92 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onur1/data/result"
+	"github.com/stretchr/testify/assert"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestRetrySucceedsEventually(t *testing.T) {
+	var attempts int
+
+	_, err := result.Retry(func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errFailed
+		}
+		return 42, nil
+	}, result.RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Millisecond,
+		Multiplier:   1,
+	})()
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryExhaustsAttempts(t *testing.T) {
+	var attempts int
+
+	_, err := result.Retry(func() (int, error) {
+		attempts++
+		return 0, errFailed
+	}, result.RetryPolicy{
+		MaxAttempts:  3,
+		InitialDelay: time.Millisecond,
+		Multiplier:   1,
+	})()
+
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetryStopsOnNonRetryableError(t *testing.T) {
+	var attempts int
+
+	_, err := result.Retry(func() (int, error) {
+		attempts++
+		return 0, errNotFound
+	}, result.RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Millisecond,
+		Multiplier:   1,
+		ShouldRetry: func(err error) bool {
+			return !errors.Is(err, errNotFound)
+		},
+	})()
+
+	assert.ErrorIs(t, err, errNotFound)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetryN(t *testing.T) {
+	var attempts int
+
+	_, err := result.RetryN(func() (int, error) {
+		attempts++
+		return 0, errFailed
+	}, 2, time.Millisecond)()
+
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetryWhile(t *testing.T) {
+	var attempts int
+
+	_, err := result.RetryWhile(func() (int, error) {
+		attempts++
+		return 0, errNotFound
+	}, 5, time.Millisecond, func(err error) bool {
+		return !errors.Is(err, errNotFound)
+	})()
+
+	assert.ErrorIs(t, err, errNotFound)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,127 @@
+package result
+
+import (
+	"sync"
+
+	"github.com/onur1/data"
+)
+
+// Traverse maps f over xs and collects the results into a data.Result[[]B],
+// failing fast with the first error encountered. Evaluation happens
+// left-to-right and the partial slice is discarded on failure.
+func Traverse[A, B any](xs []A, f func(A) data.Result[B]) data.Result[[]B] {
+	return func() ([]B, error) {
+		bs := make([]B, len(xs))
+		for i, a := range xs {
+			b, err := f(a)()
+			if err != nil {
+				return nil, err
+			}
+			bs[i] = b
+		}
+		return bs, nil
+	}
+}
+
+// Sequence collects a slice of results into a data.Result[[]A], failing
+// fast with the first error encountered.
+func Sequence[A any](rs []data.Result[A]) data.Result[[]A] {
+	return Traverse(rs, func(ma data.Result[A]) data.Result[A] {
+		return ma
+	})
+}
+
+// TraverseMap maps f over the values of xs and collects the results into a
+// data.Result[map[K]B], failing fast with the first error encountered.
+func TraverseMap[K comparable, A, B any](xs map[K]A, f func(A) data.Result[B]) data.Result[map[K]B] {
+	return func() (map[K]B, error) {
+		bs := make(map[K]B, len(xs))
+		for k, a := range xs {
+			b, err := f(a)()
+			if err != nil {
+				return nil, err
+			}
+			bs[k] = b
+		}
+		return bs, nil
+	}
+}
+
+// SequenceMap collects a map of results into a data.Result[map[K]A],
+// failing fast with the first error encountered.
+func SequenceMap[K comparable, A any](rs map[K]data.Result[A]) data.Result[map[K]A] {
+	return TraverseMap(rs, func(ma data.Result[A]) data.Result[A] {
+		return ma
+	})
+}
+
+// TraversePar is like Traverse but evaluates f over xs concurrently using a
+// worker pool of at most maxConcurrency workers. The output preserves the
+// order of xs and the first observed error is returned; once an error is
+// observed, work not yet dispatched to a worker is skipped, though calls to
+// f already in flight are allowed to finish (data.Result carries no
+// cancellation signal of its own).
+func TraversePar[A, B any](xs []A, f func(A) data.Result[B], maxConcurrency int) data.Result[[]B] {
+	return func() ([]B, error) {
+		if len(xs) == 0 {
+			return nil, nil
+		}
+
+		if maxConcurrency <= 0 || maxConcurrency > len(xs) {
+			maxConcurrency = len(xs)
+		}
+
+		bs := make([]B, len(xs))
+
+		var (
+			wg       sync.WaitGroup
+			once     sync.Once
+			firstErr error
+			jobs     = make(chan int)
+			stop     = make(chan struct{})
+		)
+
+		worker := func() {
+			defer wg.Done()
+			for i := range jobs {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				b, err := f(xs[i])()
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						close(stop)
+					})
+					continue
+				}
+
+				bs[i] = b
+			}
+		}
+
+		wg.Add(maxConcurrency)
+		for i := 0; i < maxConcurrency; i++ {
+			go worker()
+		}
+
+		for i := range xs {
+			select {
+			case jobs <- i:
+			case <-stop:
+			}
+		}
+		close(jobs)
+
+		wg.Wait()
+
+		if firstErr != nil {
+			return nil, firstErr
+		}
+
+		return bs, nil
+	}
+}
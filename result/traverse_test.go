@@ -0,0 +1,89 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/onur1/data"
+	"github.com/onur1/data/result"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraverse(t *testing.T) {
+	bs, err := result.Traverse([]int{1, 2, 3}, func(n int) data.Result[int] {
+		return result.Ok(n * 2)
+	})()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6}, bs)
+}
+
+func TestTraverseEmpty(t *testing.T) {
+	bs, err := result.Traverse([]int{}, func(n int) data.Result[int] {
+		return result.Ok(n)
+	})()
+
+	assert.NoError(t, err)
+	assert.Empty(t, bs)
+}
+
+func TestTraverseShortCircuits(t *testing.T) {
+	var calls int
+
+	_, err := result.Traverse([]int{1, 2, 3}, func(n int) data.Result[int] {
+		calls++
+		if n == 2 {
+			return result.Error[int](errFailed)
+		}
+		return result.Ok(n)
+	})()
+
+	assert.ErrorIs(t, err, errFailed)
+	assert.Equal(t, 2, calls, "Traverse must stop at the first error")
+}
+
+func TestSequence(t *testing.T) {
+	as, err := result.Sequence([]data.Result[int]{
+		result.Ok(1),
+		result.Ok(2),
+		result.Error[int](errFailed),
+	})()
+
+	assert.ErrorIs(t, err, errFailed)
+	assert.Nil(t, as)
+}
+
+func TestTraverseMap(t *testing.T) {
+	bs, err := result.TraverseMap(map[string]int{"a": 1, "b": 2}, func(n int) data.Result[int] {
+		return result.Ok(n * 10)
+	})()
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 10, "b": 20}, bs)
+}
+
+func TestSequenceMap(t *testing.T) {
+	_, err := result.SequenceMap(map[string]data.Result[int]{
+		"a": result.Ok(1),
+		"b": result.Error[int](errFailed),
+	})()
+
+	assert.ErrorIs(t, err, errFailed)
+}
+
+func TestTraverseParOrderAndError(t *testing.T) {
+	bs, err := result.TraversePar([]int{1, 2, 3, 4, 5}, func(n int) data.Result[int] {
+		return result.Ok(n * n)
+	}, 3)()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 4, 9, 16, 25}, bs)
+
+	_, err = result.TraversePar([]int{1, 2, 3}, func(n int) data.Result[int] {
+		if n == 2 {
+			return result.Error[int](errFailed)
+		}
+		return result.Ok(n)
+	}, 2)()
+
+	assert.ErrorIs(t, err, errFailed)
+}